@@ -10,6 +10,7 @@ import (
 
 	"golang.org/x/net/context"
 
+	"github.com/Sirupsen/logrus"
 	Cli "github.com/docker/docker/cli"
 	"github.com/docker/docker/cliconfig"
 	"github.com/docker/docker/cliconfig/credentials"
@@ -144,34 +145,69 @@ func readInput(in io.Reader, out io.Writer) string {
 // getCredentials loads the user credentials from a credentials store.
 // The store is determined by the config file settings.
 func getCredentials(c *cliconfig.ConfigFile, serverAddress string) (types.AuthConfig, error) {
-	s := loadCredentialsStore(c)
+	s := loadCredentialsStore(c, serverAddress)
 	return s.Get(serverAddress)
 }
 
+// getAllCredentials loads the credentials of every registry known
+// to the default store and to the per-registry credential helpers.
+// Credentials from a registry specific helper take precedence.
 func getAllCredentials(c *cliconfig.ConfigFile) (map[string]types.AuthConfig, error) {
-	s := loadCredentialsStore(c)
-	return s.GetAll()
+	s := loadCredentialsStore(c, "")
+	all, err := s.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	// The file store returns the config file's own map, copy it so
+	// credentials from helpers never end up in the config file.
+	auths := make(map[string]types.AuthConfig, len(all))
+	for serverAddress, ac := range all {
+		auths[serverAddress] = ac
+	}
+
+	for serverAddress := range c.CredentialHelpers {
+		ac, err := getCredentials(c, serverAddress)
+		if err != nil {
+			logrus.Debugf("error getting credentials for %s from its helper: %v", serverAddress, err)
+			continue
+		}
+		auths[serverAddress] = ac
+	}
+	return auths, nil
 }
 
 // storeCredentials saves the user credentials in a credentials store.
 // The store is determined by the config file settings.
 func storeCredentials(c *cliconfig.ConfigFile, auth types.AuthConfig) error {
-	s := loadCredentialsStore(c)
+	s := loadCredentialsStore(c, auth.ServerAddress)
 	return s.Store(auth)
 }
 
 // eraseCredentials removes the user credentials from a credentials store.
 // The store is determined by the config file settings.
 func eraseCredentials(c *cliconfig.ConfigFile, serverAddress string) error {
-	s := loadCredentialsStore(c)
+	s := loadCredentialsStore(c, serverAddress)
 	return s.Erase(serverAddress)
 }
 
 // loadCredentialsStore initializes a new credentials store based
 // in the settings provided in the configuration file.
-func loadCredentialsStore(c *cliconfig.ConfigFile) credentials.Store {
-	if c.CredentialsStore != "" {
-		return credentials.NewNativeStore(c)
+// A helper configured for serverAddress in credHelpers overrides
+// the default credsStore.
+func loadCredentialsStore(c *cliconfig.ConfigFile, serverAddress string) credentials.Store {
+	if helper := getConfiguredCredentialStore(c, serverAddress); helper != "" {
+		return credentials.NewNativeStore(c, helper)
 	}
 	return credentials.NewFileStore(c)
 }
+
+// getConfiguredCredentialStore returns the name of the credentials helper
+// configured for the given registry, or the default credentials store
+// if no helper is set for it.
+func getConfiguredCredentialStore(c *cliconfig.ConfigFile, serverAddress string) string {
+	if helper, exists := c.CredentialHelpers[serverAddress]; exists && serverAddress != "" {
+		return helper
+	}
+	return c.CredentialsStore
+}
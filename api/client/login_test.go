@@ -0,0 +1,135 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/docker/docker/cliconfig"
+	"github.com/docker/engine-api/types"
+)
+
+func TestGetConfiguredCredentialStore(t *testing.T) {
+	c := &cliconfig.ConfigFile{
+		CredentialsStore: "default-store",
+		CredentialHelpers: map[string]string{
+			"images.io": "images-io",
+		},
+	}
+
+	cases := []struct {
+		serverAddress string
+		expected      string
+	}{
+		{"images.io", "images-io"},
+		{"containers.com", "default-store"},
+		{"", "default-store"},
+	}
+
+	for _, tc := range cases {
+		if store := getConfiguredCredentialStore(c, tc.serverAddress); store != tc.expected {
+			t.Fatalf("Expected store %q for %q, got %q", tc.expected, tc.serverAddress, store)
+		}
+	}
+
+	c.CredentialHelpers[""] = "empty"
+	if store := getConfiguredCredentialStore(c, ""); store != "default-store" {
+		t.Fatalf("Expected the default store for an empty address, got %q", store)
+	}
+}
+
+// withTestCredentialHelper installs a docker-credential-test helper
+// in PATH that returns the same credentials for every registry.
+func withTestCredentialHelper(t *testing.T) func() {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential helper script requires a unix shell")
+	}
+
+	dir, err := ioutil.TempDir("", "credential-helper-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	script := "#!/bin/sh\necho '{\"Username\":\"helper-user\",\"Secret\":\"helper-secret\"}'\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "docker-credential-test"), []byte(script), 0755); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+path)
+	return func() {
+		os.Setenv("PATH", path)
+		os.RemoveAll(dir)
+	}
+}
+
+func newTestCredentialsConfig() *cliconfig.ConfigFile {
+	return &cliconfig.ConfigFile{
+		AuthConfigs: map[string]types.AuthConfig{
+			"images.io": {
+				Username:      "file-user",
+				Password:      "file-secret",
+				ServerAddress: "images.io",
+			},
+			"containers.com": {
+				Username:      "other-user",
+				Password:      "other-secret",
+				ServerAddress: "containers.com",
+			},
+		},
+		CredentialHelpers: map[string]string{
+			"images.io": "test",
+		},
+	}
+}
+
+func TestLoadCredentialsStore(t *testing.T) {
+	defer withTestCredentialHelper(t)()
+	c := newTestCredentialsConfig()
+
+	cases := []struct {
+		loadAddress string
+		getAddress  string
+		expected    string
+	}{
+		{"images.io", "images.io", "helper-user"},
+		{"containers.com", "containers.com", "other-user"},
+		{"", "images.io", "file-user"},
+	}
+
+	for _, tc := range cases {
+		ac, err := loadCredentialsStore(c, tc.loadAddress).Get(tc.getAddress)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ac.Username != tc.expected {
+			t.Fatalf("Expected user %q for %q, got %q", tc.expected, tc.getAddress, ac.Username)
+		}
+	}
+}
+
+func TestGetAllCredentials(t *testing.T) {
+	defer withTestCredentialHelper(t)()
+	c := newTestCredentialsConfig()
+
+	auths, err := getAllCredentials(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(auths) != 2 {
+		t.Fatalf("Expected 2 credentials, got %d", len(auths))
+	}
+	if ac := auths["images.io"]; ac.Username != "helper-user" || ac.Password != "helper-secret" {
+		t.Fatalf("Expected credentials from the helper for images.io, got %v", ac)
+	}
+	if ac := auths["containers.com"]; ac.Username != "other-user" || ac.Password != "other-secret" {
+		t.Fatalf("Expected credentials from the file for containers.com, got %v", ac)
+	}
+
+	if ac := c.AuthConfigs["images.io"]; ac.Username != "file-user" || ac.Password != "file-secret" {
+		t.Fatalf("Expected the config file credentials to be left untouched, got %v", ac)
+	}
+}
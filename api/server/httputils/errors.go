@@ -68,13 +68,52 @@ func GetHTTPErrorStatusCode(err error) int {
 	return statusCode
 }
 
+// ErrorResponse is the JSON body sent for a failed request when the
+// client asks for application/json.
+type ErrorResponse struct {
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// errorCodes maps response status codes to the stable error codes
+// reported in ErrorResponse.
+var errorCodes = map[int]string{
+	http.StatusBadRequest:          "BadRequest",
+	http.StatusUnauthorized:        "Unauthorized",
+	http.StatusForbidden:           "Forbidden",
+	http.StatusNotFound:            "NotFound",
+	http.StatusNotAcceptable:       "NotAcceptable",
+	http.StatusConflict:            "Conflict",
+	http.StatusInternalServerError: "InternalError",
+}
+
+// GetErrorCode returns the stable error code for a response status code.
+func GetErrorCode(statusCode int) string {
+	if code, ok := errorCodes[statusCode]; ok {
+		return code
+	}
+	return errorCodes[http.StatusInternalServerError]
+}
+
 // WriteError decodes a specific docker error and sends it in the response.
-func WriteError(w http.ResponseWriter, err error) {
+// Clients that accept application/json get an ErrorResponse, everyone
+// else gets the error message as plain text.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
 	if err == nil || w == nil {
 		logrus.WithFields(logrus.Fields{"error": err, "writer": w}).Error("unexpected HTTP error handling")
 		return
 	}
 
 	statusCode := GetHTTPErrorStatusCode(err)
-	http.Error(w, err.Error(), statusCode)
+	if r == nil || !strings.Contains(r.Header.Get("Accept"), "application/json") {
+		http.Error(w, err.Error(), statusCode)
+		return
+	}
+
+	WriteJSON(w, statusCode, &ErrorResponse{
+		Code:      GetErrorCode(statusCode),
+		Message:   err.Error(),
+		RequestID: w.Header().Get(RequestIDHeader),
+	})
 }
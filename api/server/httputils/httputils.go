@@ -19,6 +19,13 @@ const APIVersionKey = "api-version"
 // UAStringKey is used as key type for user-agent string in net/context struct
 const UAStringKey = "upstream-user-agent"
 
+// RequestIDKey is the identifier the server assigns to every API request.
+const RequestIDKey = "docker-request-id"
+
+// RequestIDHeader is the response header the request identifier is sent in,
+// so it can be matched against the daemon logs.
+const RequestIDHeader = "Docker-Request-Id"
+
 // APIFunc is an adapter to allow the use of ordinary functions as Docker API endpoints.
 // Any function that has the appropriate signature can be registered as a API endpoint (e.g. getVersion).
 type APIFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error
@@ -105,3 +112,13 @@ func VersionFromContext(ctx context.Context) (ver version.Version) {
 	}
 	return val.(version.Version)
 }
+
+// RequestIDFromContext returns the identifier assigned to the request
+// associated with the given context.
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	val, _ := ctx.Value(RequestIDKey).(string)
+	return val
+}
@@ -15,7 +15,7 @@ import (
 // DebugRequestMiddleware dumps the request to logger
 func DebugRequestMiddleware(handler func(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error) func(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
 	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
-		logrus.Debugf("Calling %s %s", r.Method, r.RequestURI)
+		logrus.WithField("request-id", httputils.RequestIDFromContext(ctx)).Debugf("Calling %s %s", r.Method, r.RequestURI)
 
 		if r.Method != "POST" {
 			return handler(ctx, w, r, vars)
@@ -2,6 +2,7 @@ package server
 
 import (
 	"crypto/tls"
+	"fmt"
 	"net"
 	"net/http"
 	"strings"
@@ -10,6 +11,8 @@ import (
 	"github.com/docker/docker/api/server/httputils"
 	"github.com/docker/docker/api/server/middleware"
 	"github.com/docker/docker/api/server/router"
+	"github.com/docker/docker/errors"
+	"github.com/docker/docker/pkg/stringid"
 	"github.com/gorilla/mux"
 	"golang.org/x/net/context"
 )
@@ -126,7 +129,10 @@ func (s *Server) makeHTTPHandler(handler httputils.APIFunc) http.HandlerFunc {
 		// apply to all requests. Data that is specific to the
 		// immediate function being called should still be passed
 		// as 'args' on the function call.
-		ctx := context.Background()
+		requestID := stringid.TruncateID(stringid.GenerateNonCryptoID())
+		w.Header().Set(httputils.RequestIDHeader, requestID)
+
+		ctx := context.WithValue(context.Background(), httputils.RequestIDKey, requestID)
 		handlerFunc := s.handleWithGlobalMiddlewares(handler)
 
 		vars := mux.Vars(r)
@@ -135,8 +141,8 @@ func (s *Server) makeHTTPHandler(handler httputils.APIFunc) http.HandlerFunc {
 		}
 
 		if err := handlerFunc(ctx, w, r, vars); err != nil {
-			logrus.Errorf("Handler for %s %s returned error: %v", r.Method, r.URL.Path, err)
-			httputils.WriteError(w, err)
+			logrus.WithField("request-id", requestID).Errorf("Handler for %s %s returned error: %v", r.Method, r.URL.Path, err)
+			httputils.WriteError(w, r, err)
 		}
 	}
 }
@@ -172,9 +178,17 @@ func (s *Server) createMux() *mux.Router {
 		}
 	}
 
+	// Unknown routes go through the same handler chain so they get a
+	// request id and the same error format as any other failure.
+	m.NotFoundHandler = s.makeHTTPHandler(notFoundHandler)
+
 	return m
 }
 
+func notFoundHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	return errors.NewRequestNotFoundError(fmt.Errorf("page not found"))
+}
+
 // Wait blocks the server goroutine until it exits.
 // It sends an error message if there is any error during
 // the API execution.
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -45,3 +47,96 @@ func TestMiddlewares(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestRequestID(t *testing.T) {
+	srv := &Server{
+		cfg: &Config{},
+	}
+
+	var ctxID string
+	localHandler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+		ctxID = httputils.RequestIDFromContext(ctx)
+		return nil
+	}
+
+	req, _ := http.NewRequest("GET", "/containers/json", nil)
+	resp := httptest.NewRecorder()
+	srv.makeHTTPHandler(localHandler)(resp, req)
+
+	headerID := resp.Header().Get(httputils.RequestIDHeader)
+	if headerID == "" {
+		t.Fatalf("Expected a request id in the %s header", httputils.RequestIDHeader)
+	}
+	if ctxID != headerID {
+		t.Fatalf("Expected request id %s in the context, got %s", headerID, ctxID)
+	}
+}
+
+func TestErrorResponse(t *testing.T) {
+	srv := &Server{
+		cfg: &Config{},
+	}
+
+	localHandler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+		return errors.New("No such container: foo")
+	}
+
+	req, _ := http.NewRequest("GET", "/containers/foo/json", nil)
+	resp := httptest.NewRecorder()
+	srv.makeHTTPHandler(localHandler)(resp, req)
+
+	if resp.Code != http.StatusNotFound {
+		t.Fatalf("Expected status %d, got %d", http.StatusNotFound, resp.Code)
+	}
+	if body := strings.TrimSpace(resp.Body.String()); body != "No such container: foo" {
+		t.Fatalf("Expected a plain text error, got %s", body)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	resp = httptest.NewRecorder()
+	srv.makeHTTPHandler(localHandler)(resp, req)
+
+	var errResp httputils.ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+		t.Fatal(err)
+	}
+	if errResp.Code != "NotFound" {
+		t.Fatalf("Expected code NotFound, got %s", errResp.Code)
+	}
+	if errResp.Message != "No such container: foo" {
+		t.Fatalf("Expected message No such container: foo, got %s", errResp.Message)
+	}
+	if id := resp.Header().Get(httputils.RequestIDHeader); errResp.RequestID != id {
+		t.Fatalf("Expected request id %s, got %s", id, errResp.RequestID)
+	}
+}
+
+func TestUnknownRouteErrorResponse(t *testing.T) {
+	srv := &Server{
+		cfg: &Config{},
+	}
+
+	req, _ := http.NewRequest("GET", "/v1.24/unknown/route", nil)
+	req.Header.Set("Accept", "application/json")
+	resp := httptest.NewRecorder()
+	srv.createMux().ServeHTTP(resp, req)
+
+	if resp.Code != http.StatusNotFound {
+		t.Fatalf("Expected status %d, got %d", http.StatusNotFound, resp.Code)
+	}
+	id := resp.Header().Get(httputils.RequestIDHeader)
+	if id == "" {
+		t.Fatal("Expected a request id for an unknown route")
+	}
+
+	var errResp httputils.ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+		t.Fatal(err)
+	}
+	if errResp.Code != "NotFound" {
+		t.Fatalf("Expected code NotFound, got %s", errResp.Code)
+	}
+	if errResp.RequestID != id {
+		t.Fatalf("Expected request id %s, got %s", id, errResp.RequestID)
+	}
+}
@@ -48,13 +48,14 @@ func SetConfigDir(dir string) {
 
 // ConfigFile ~/.docker/config.json file info
 type ConfigFile struct {
-	AuthConfigs      map[string]types.AuthConfig `json:"auths"`
-	HTTPHeaders      map[string]string           `json:"HttpHeaders,omitempty"`
-	PsFormat         string                      `json:"psFormat,omitempty"`
-	ImagesFormat     string                      `json:"imagesFormat,omitempty"`
-	DetachKeys       string                      `json:"detachKeys,omitempty"`
-	CredentialsStore string                      `json:"credsStore,omitempty"`
-	filename         string                      // Note: not serialized - for internal use only
+	AuthConfigs       map[string]types.AuthConfig `json:"auths"`
+	HTTPHeaders       map[string]string           `json:"HttpHeaders,omitempty"`
+	PsFormat          string                      `json:"psFormat,omitempty"`
+	ImagesFormat      string                      `json:"imagesFormat,omitempty"`
+	DetachKeys        string                      `json:"detachKeys,omitempty"`
+	CredentialsStore  string                      `json:"credsStore,omitempty"`
+	CredentialHelpers map[string]string           `json:"credHelpers,omitempty"`
+	filename          string                      // Note: not serialized - for internal use only
 }
 
 // NewConfigFile initializes an empty configuration file for the given filename 'fn'
@@ -127,6 +128,7 @@ func (configFile *ConfigFile) LoadFromReader(configData io.Reader) error {
 // in this file or not.
 func (configFile *ConfigFile) ContainsAuth() bool {
 	return configFile.CredentialsStore != "" ||
+		len(configFile.CredentialHelpers) > 0 ||
 		(configFile.AuthConfigs != nil && len(configFile.AuthConfigs) > 0)
 }
 
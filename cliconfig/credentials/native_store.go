@@ -52,9 +52,10 @@ type nativeStore struct {
 
 // NewNativeStore creates a new native store that
 // uses a remote helper program to manage credentials.
-func NewNativeStore(file *cliconfig.ConfigFile) Store {
+// The helper program is docker-credential- followed by helperSuffix.
+func NewNativeStore(file *cliconfig.ConfigFile, helperSuffix string) Store {
 	return &nativeStore{
-		commandFn: shellCommandFn(file.CredentialsStore),
+		commandFn: shellCommandFn(helperSuffix),
 		fileStore: NewFileStore(file),
 	}
 }
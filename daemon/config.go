@@ -352,7 +352,7 @@ func findConfigurationConflicts(config map[string]interface{}, flags *flag.FlagS
 }
 
 // validateConfiguration validates some specific configs.
-// such as config.DNS, config.Labels, config.DNSSearch
+// such as config.DNS, config.Labels, config.DNSSearch, config.LogLevel
 func validateConfiguration(config *Config) error {
 	// validate DNS
 	for _, dns := range config.DNS {
@@ -375,5 +375,12 @@ func validateConfiguration(config *Config) error {
 		}
 	}
 
+	// validate LogLevel
+	if config.LogLevel != "" {
+		if _, err := logrus.ParseLevel(config.LogLevel); err != nil {
+			return fmt.Errorf("invalid logging level: %s", config.LogLevel)
+		}
+	}
+
 	return nil
 }
@@ -14,6 +14,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 	"syscall"
@@ -63,6 +64,7 @@ import (
 	"github.com/docker/docker/registry"
 	"github.com/docker/docker/runconfig"
 	"github.com/docker/docker/utils"
+	"github.com/docker/docker/volume"
 	volumedrivers "github.com/docker/docker/volume/drivers"
 	"github.com/docker/docker/volume/local"
 	"github.com/docker/docker/volume/store"
@@ -236,6 +238,7 @@ func (daemon *Daemon) restore() error {
 		debug         = utils.IsDebugEnabled()
 		currentDriver = daemon.GraphDriverName()
 		containers    = make(map[string]*container.Container)
+		failures      restoreFailures
 	)
 
 	if !debug {
@@ -254,6 +257,7 @@ func (daemon *Daemon) restore() error {
 		}
 		if err != nil {
 			logrus.Errorf("Failed to load container %v: %v", id, err)
+			failures.load = append(failures.load, stringid.TruncateID(id))
 			continue
 		}
 
@@ -262,6 +266,7 @@ func (daemon *Daemon) restore() error {
 			rwlayer, err := daemon.layerStore.GetRWLayer(container.ID)
 			if err != nil {
 				logrus.Errorf("Failed to load container mount %v: %v", id, err)
+				failures.load = append(failures.load, strings.TrimPrefix(container.Name, "/"))
 				continue
 			}
 			container.RWLayer = rwlayer
@@ -278,10 +283,14 @@ func (daemon *Daemon) restore() error {
 	for _, c := range containers {
 		if err := daemon.registerName(c); err != nil {
 			logrus.Errorf("Failed to register container %s: %s", c.ID, err)
+			failures.load = append(failures.load, strings.TrimPrefix(c.Name, "/"))
+			delete(containers, c.ID)
 			continue
 		}
 		if err := daemon.Register(c); err != nil {
 			logrus.Errorf("Failed to register container %s: %s", c.ID, err)
+			failures.load = append(failures.load, strings.TrimPrefix(c.Name, "/"))
+			delete(containers, c.ID)
 			continue
 		}
 	}
@@ -296,10 +305,16 @@ func (daemon *Daemon) restore() error {
 				// Fix activityCount such that graph mounts can be unmounted later
 				if err := daemon.layerStore.ReinitRWLayer(c.RWLayer); err != nil {
 					logrus.Errorf("Failed to ReinitRWLayer for %s due to %s", c.ID, err)
+					mapLock.Lock()
+					failures.restore = append(failures.restore, strings.TrimPrefix(c.Name, "/"))
+					mapLock.Unlock()
 					return
 				}
 				if err := daemon.containerd.Restore(c.ID, libcontainerd.WithRestartManager(rm)); err != nil {
 					logrus.Errorf("Failed to restore with containerd: %q", err)
+					mapLock.Lock()
+					failures.restore = append(failures.restore, strings.TrimPrefix(c.Name, "/"))
+					mapLock.Unlock()
 					return
 				}
 			}
@@ -351,20 +366,14 @@ func (daemon *Daemon) restore() error {
 
 			logrus.Debugf("Starting container %s", c.ID)
 
-			// ignore errors here as this is a best effort to wait for children to be
-			//   running before we try to start the container
-			children := daemon.children(c)
-			timeout := time.After(5 * time.Second)
-			for _, child := range children {
-				if notifier, exists := restartContainers[child]; exists {
-					select {
-					case <-notifier:
-					case <-timeout:
-					}
-				}
-			}
+			// ignore errors here as this is a best effort to wait for dependencies
+			//   to be running before we try to start the container
+			waitDependencies(daemon.restartDependencies(c), restartContainers, 5*time.Second)
 			if err := daemon.containerStart(c); err != nil {
 				logrus.Errorf("Failed to start container %s: %s", c.ID, err)
+				mapLock.Lock()
+				failures.restart = append(failures.restart, strings.TrimPrefix(c.Name, "/"))
+				mapLock.Unlock()
 			}
 			close(chNotify)
 		}(c, notifier)
@@ -372,6 +381,10 @@ func (daemon *Daemon) restore() error {
 	}
 	group.Wait()
 
+	for _, warning := range failures.summary(len(restartContainers)) {
+		logrus.Warn(warning)
+	}
+
 	// any containers that were started above would already have had this done,
 	// however we need to now prepare the mountpoints for the rest of the containers as well.
 	// This shouldn't cause any issue running on the containers that already had this run.
@@ -406,6 +419,36 @@ func (daemon *Daemon) restore() error {
 	return nil
 }
 
+// restoreFailures holds the names of the containers that did not come
+// back while the daemon restored them.
+type restoreFailures struct {
+	// load holds the containers that could not be loaded or registered.
+	load []string
+	// restore holds the running containers that could not be restored.
+	restore []string
+	// restart holds the containers with a restart policy that failed to start.
+	restart []string
+}
+
+// summary returns the warnings logged once the containers are restored,
+// given the number of containers that had to be restarted.
+func (f *restoreFailures) summary(restarts int) []string {
+	var warnings []string
+	if len(f.load) > 0 {
+		sort.Strings(f.load)
+		warnings = append(warnings, fmt.Sprintf("%d containers could not be loaded: %s", len(f.load), strings.Join(f.load, ", ")))
+	}
+	if len(f.restore) > 0 {
+		sort.Strings(f.restore)
+		warnings = append(warnings, fmt.Sprintf("%d running containers could not be restored: %s", len(f.restore), strings.Join(f.restore, ", ")))
+	}
+	if len(f.restart) > 0 {
+		sort.Strings(f.restart)
+		warnings = append(warnings, fmt.Sprintf("%d of %d containers with a restart policy failed to start: %s", len(f.restart), restarts, strings.Join(f.restart, ", ")))
+	}
+	return warnings
+}
+
 func (daemon *Daemon) mergeAndVerifyConfig(config *containertypes.Config, img *image.Image) error {
 	if img != nil && img.Config != nil {
 		if err := merge(config, img.Config); err != nil {
@@ -578,6 +621,64 @@ func (daemon *Daemon) children(c *container.Container) map[string]*container.Con
 	return daemon.linkIndex.children(c)
 }
 
+// waitDependencies waits until the dependencies that are being restarted
+// have been started, giving up on all of them once timeout has passed.
+// Dependencies are looked up by name, so they can form a cycle.
+func waitDependencies(dependencies []*container.Container, restartContainers map[*container.Container]chan struct{}, timeout time.Duration) {
+	expired := make(chan struct{})
+	timer := time.AfterFunc(timeout, func() { close(expired) })
+	defer timer.Stop()
+
+	for _, dependency := range dependencies {
+		if notifier, exists := restartContainers[dependency]; exists {
+			select {
+			case <-notifier:
+			case <-expired:
+				return
+			}
+		}
+	}
+}
+
+// restartDependencies returns the containers that have to be running
+// before the given container is started: its linked children, the
+// containers it mounts volumes from and the containers whose network or
+// IPC namespace it joins.
+func (daemon *Daemon) restartDependencies(c *container.Container) []*container.Container {
+	var dependencies []*container.Container
+	for _, child := range daemon.children(c) {
+		dependencies = append(dependencies, child)
+	}
+	if c.HostConfig == nil {
+		return dependencies
+	}
+
+	var names []string
+	if c.HostConfig.NetworkMode.IsContainer() {
+		names = append(names, c.HostConfig.NetworkMode.ConnectedContainer())
+	}
+	if c.HostConfig.IpcMode.IsContainer() {
+		names = append(names, c.HostConfig.IpcMode.Container())
+	}
+	for _, spec := range c.HostConfig.VolumesFrom {
+		id, _, err := volume.ParseVolumesFrom(spec)
+		if err != nil {
+			continue
+		}
+		names = append(names, id)
+	}
+
+	for _, name := range names {
+		dependency, err := daemon.GetContainer(name)
+		if err != nil {
+			logrus.Debugf("Dependency %s of container %s not found: %v", name, c.ID, err)
+			continue
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return dependencies
+}
+
 // parents returns the names of the parent containers of the container
 // with the given name.
 func (daemon *Daemon) parents(c *container.Container) map[string]*container.Container {
@@ -597,6 +698,12 @@ func (daemon *Daemon) registerLink(parent, child *container.Container, alias str
 	return nil
 }
 
+// VerifySettings checks the daemon configuration for invalid or
+// incompatible options, as NewDaemon does before setting up the daemon.
+func VerifySettings(config *Config) error {
+	return verifyDaemonSettings(config)
+}
+
 // NewDaemon sets up everything for the daemon to be able to service
 // requests from the webserver.
 func NewDaemon(config *Config, registryService *registry.Service, containerdRemote libcontainerd.Remote) (daemon *Daemon, err error) {
@@ -1501,6 +1608,7 @@ func (daemon *Daemon) initDiscovery(config *Config) error {
 // These are the settings that Reload changes:
 // - Daemon labels.
 // - Daemon debug log level.
+// - Daemon log level.
 // - Cluster discovery (reconfigure and restart).
 func (daemon *Daemon) Reload(config *Config) error {
 	daemon.configStore.reloadLock.Lock()
@@ -1511,6 +1619,9 @@ func (daemon *Daemon) Reload(config *Config) error {
 	if config.IsValueSet("debug") {
 		daemon.configStore.Debug = config.Debug
 	}
+	if config.IsValueSet("log-level") {
+		daemon.configStore.LogLevel = config.LogLevel
+	}
 	return daemon.reloadClusterDiscovery(config)
 }
 
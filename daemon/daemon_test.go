@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -311,6 +312,123 @@ func TestMerge(t *testing.T) {
 	}
 }
 
+func TestRestartDependencies(t *testing.T) {
+	newContainer := func(id, name string) *container.Container {
+		return &container.Container{
+			CommonContainer: container.CommonContainer{
+				ID:         id,
+				Name:       name,
+				HostConfig: &containertypes.HostConfig{},
+			},
+		}
+	}
+	app := newContainer("5a4ff6a163ad4533d22d69a2b8960bf7fafdcba06e72d2febdba229008b0bf57", "/app")
+	netns := newContainer("3cdbd1aa394fd68559fd1441d6eff2ab7c1e6363582c82febfaa8045df3bd8de", "/netns")
+	data := newContainer("75fb0b800922abdbef2d27e60abcdfaf7fb0698b2a96d22d3354da361a6ff4a5", "/data")
+	db := newContainer("d22d69a2b8960bf7fafdcba06e72d2febdba960bf7fafdcba06e72d2f9008b060b", "/db")
+	unrelated := newContainer("3cdbd1aa394fd68559fd1441d6eff2abfafdcba06e72d2febdba229008b0bf57", "/unrelated")
+
+	app.HostConfig.NetworkMode = containertypes.NetworkMode("container:netns")
+	app.HostConfig.VolumesFrom = []string{"data:ro", "missing"}
+
+	store := container.NewMemoryStore()
+	index := truncindex.NewTruncIndex([]string{})
+	daemon := &Daemon{
+		containers: store,
+		idIndex:    index,
+		nameIndex:  registrar.NewRegistrar(),
+		linkIndex:  newLinkIndex(),
+	}
+	for _, c := range []*container.Container{app, netns, data, db, unrelated} {
+		store.Add(c.ID, c)
+		index.Add(c.ID)
+		daemon.reserveName(c.ID, c.Name)
+	}
+	daemon.linkIndex.link(app, db, "/app/db")
+
+	dependencies := daemon.restartDependencies(app)
+	if len(dependencies) != 3 {
+		t.Fatalf("Expected 3 dependencies, got %d", len(dependencies))
+	}
+	found := make(map[*container.Container]bool)
+	for _, c := range dependencies {
+		found[c] = true
+	}
+	for _, c := range []*container.Container{netns, data, db} {
+		if !found[c] {
+			t.Fatalf("Expected %s to be a dependency of %s", c.Name, app.Name)
+		}
+	}
+
+	if dependencies := daemon.restartDependencies(unrelated); len(dependencies) != 0 {
+		t.Fatalf("Expected no dependencies, got %d", len(dependencies))
+	}
+}
+
+func TestWaitDependenciesCycle(t *testing.T) {
+	a := &container.Container{CommonContainer: container.CommonContainer{ID: "a", Name: "/a"}}
+	b := &container.Container{CommonContainer: container.CommonContainer{ID: "b", Name: "/b"}}
+	c := &container.Container{CommonContainer: container.CommonContainer{ID: "c", Name: "/c"}}
+	restartContainers := map[*container.Container]chan struct{}{
+		a: make(chan struct{}),
+		b: make(chan struct{}),
+		c: make(chan struct{}),
+	}
+	// a and b wait for each other, and c never starts
+	dependencies := map[*container.Container][]*container.Container{
+		a: {c, b},
+		b: {c, a},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		var wg sync.WaitGroup
+		for _, ctr := range []*container.Container{a, b} {
+			wg.Add(1)
+			go func(ctr *container.Container) {
+				defer wg.Done()
+				waitDependencies(dependencies[ctr], restartContainers, 100*time.Millisecond)
+				close(restartContainers[ctr])
+			}(ctr)
+		}
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for containers with cyclic dependencies")
+	}
+}
+
+func TestRestoreSummary(t *testing.T) {
+	var failures restoreFailures
+	if warnings := failures.summary(3); len(warnings) != 0 {
+		t.Fatalf("Expected no warnings, got %v", warnings)
+	}
+
+	failures = restoreFailures{
+		load:    []string{"old", "broken"},
+		restore: []string{"web", "db"},
+		restart: []string{"worker", "cache"},
+	}
+	expected := []string{
+		"2 containers could not be loaded: broken, old",
+		"2 running containers could not be restored: db, web",
+		"2 of 3 containers with a restart policy failed to start: cache, worker",
+	}
+	if warnings := failures.summary(3); !reflect.DeepEqual(warnings, expected) {
+		t.Fatalf("Expected warnings %v, got %v", expected, warnings)
+	}
+
+	failures = restoreFailures{restart: []string{"worker"}}
+	expected = []string{"1 of 1 containers with a restart policy failed to start: worker"}
+	if warnings := failures.summary(1); !reflect.DeepEqual(warnings, expected) {
+		t.Fatalf("Expected warnings %v, got %v", expected, warnings)
+	}
+}
+
 func TestDaemonReloadLabels(t *testing.T) {
 	daemon := &Daemon{}
 	daemon.configStore = &Config{
@@ -335,6 +453,29 @@ func TestDaemonReloadLabels(t *testing.T) {
 	}
 }
 
+func TestDaemonReloadLogLevel(t *testing.T) {
+	daemon := &Daemon{}
+	daemon.configStore = &Config{
+		CommonConfig: CommonConfig{
+			LogLevel: "info",
+		},
+	}
+
+	valuesSets := make(map[string]interface{})
+	valuesSets["log-level"] = "warn"
+	newConfig := &Config{
+		CommonConfig: CommonConfig{
+			LogLevel:  "warn",
+			valuesSet: valuesSets,
+		},
+	}
+
+	daemon.Reload(newConfig)
+	if daemon.configStore.LogLevel != "warn" {
+		t.Fatalf("Expected daemon log level `warn`, got %s", daemon.configStore.LogLevel)
+	}
+}
+
 func TestDaemonReloadNotAffectOthers(t *testing.T) {
 	daemon := &Daemon{}
 	daemon.configStore = &Config{
@@ -1117,6 +1117,12 @@ func (daemon *Daemon) stats(c *container.Container) (*types.StatsJSON, error) {
 	return s, nil
 }
 
+// effectiveIsolation returns the isolation technology used by a container
+// with the given isolation setting. This is only applicable on Windows
+func (daemon *Daemon) effectiveIsolation(isolation containertypes.Isolation) containertypes.Isolation {
+	return isolation
+}
+
 // setDefaultIsolation determines the default isolation mode for the
 // daemon to run in. This is only applicable on Windows
 func (daemon *Daemon) setDefaultIsolation() error {
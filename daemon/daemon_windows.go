@@ -410,6 +410,22 @@ func (daemon *Daemon) stats(c *container.Container) (*types.StatsJSON, error) {
 	return nil, nil
 }
 
+// effectiveIsolation returns the isolation technology used by a container
+// with the given isolation setting. Containers created with the default
+// isolation keep "default" in their configuration and follow the
+// daemon's default, so it is resolved when it is reported.
+func (daemon *Daemon) effectiveIsolation(isolation containertypes.Isolation) containertypes.Isolation {
+	return resolveIsolation(isolation, daemon.defaultIsolation)
+}
+
+// resolveIsolation returns defaultIsolation when isolation is the default.
+func resolveIsolation(isolation, defaultIsolation containertypes.Isolation) containertypes.Isolation {
+	if isolation.IsDefault() {
+		return defaultIsolation
+	}
+	return isolation
+}
+
 // setDefaultIsolation determine the default isolation mode for the
 // daemon to run in. This is only applicable on Windows
 func (daemon *Daemon) setDefaultIsolation() error {
@@ -1,11 +1,15 @@
 package daemon
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/docker/docker/container"
 	"github.com/docker/docker/daemon/events"
+	"github.com/docker/docker/layer"
+	"github.com/docker/docker/libcontainerd"
 	containertypes "github.com/docker/engine-api/types/container"
 	eventtypes "github.com/docker/engine-api/types/events"
 )
@@ -75,6 +79,65 @@ func TestLogContainerEventWithAttributes(t *testing.T) {
 	})
 }
 
+// unmountedLayer is a read-write layer that is never mounted, so the
+// cleanup of an exited container has nothing to unmount.
+type unmountedLayer struct {
+	layer.RWLayer
+}
+
+func (unmountedLayer) Unmount() error {
+	return nil
+}
+
+func TestDieEventRestartCount(t *testing.T) {
+	e := events.New()
+	_, l, _ := e.Subscribe()
+	defer e.Evict(l)
+
+	root, err := ioutil.TempDir("", "docker-die-event-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	c := container.NewBaseContainer("container_id", root)
+	c.Name = "container_name"
+	c.Config = &containertypes.Config{NetworkDisabled: true}
+	c.HostConfig = &containertypes.HostConfig{IpcMode: "host"}
+	c.RWLayer = unmountedLayer{}
+	c.RestartCount = 2
+
+	daemon := &Daemon{
+		containers:    container.NewMemoryStore(),
+		EventsService: e,
+	}
+	daemon.containers.Add(c.ID, c)
+
+	state := libcontainerd.StateInfo{}
+	state.State = libcontainerd.StateRestart
+	state.ExitCode = 1
+	if err := daemon.StateChanged(c.ID, state); err != nil {
+		t.Fatal(err)
+	}
+
+	validateTestAttributes(t, l, map[string]string{
+		"exitCode":     "1",
+		"restartCount": "3",
+	})
+
+	// the container exits for good without being restarted again
+	state.State = libcontainerd.StateExit
+	state.ExitCode = 137
+	if err := daemon.StateChanged(c.ID, state); err != nil {
+		t.Fatal(err)
+	}
+
+	validateTestAttributes(t, l, map[string]string{
+		"exitCode":     "137",
+		"restartCount": "3",
+	})
+}
+
 func validateTestAttributes(t *testing.T, l chan interface{}, expectedAttributesToTest map[string]string) {
 	select {
 	case ev := <-l:
@@ -118,6 +118,10 @@ func (daemon *Daemon) getInspectData(container *container.Container, size bool)
 		hostConfig.LogConfig.Config = daemon.defaultLogConfig.Config
 	}
 
+	// same for the isolation, report what the container runs with
+	// but keep "default" stored so it follows the daemon's default
+	hostConfig.Isolation = daemon.effectiveIsolation(hostConfig.Isolation)
+
 	containerState := &types.ContainerState{
 		Status:     container.State.StateString(),
 		Running:    container.State.Running,
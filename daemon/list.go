@@ -11,6 +11,7 @@ import (
 	"github.com/docker/docker/image"
 	"github.com/docker/docker/volume"
 	"github.com/docker/engine-api/types"
+	containertypes "github.com/docker/engine-api/types/container"
 	"github.com/docker/engine-api/types/filters"
 	networktypes "github.com/docker/engine-api/types/network"
 	"github.com/docker/go-connections/nat"
@@ -87,6 +88,9 @@ type listContext struct {
 	// sinceFilter is a filter to stop the filtering when the iterator arrive to the given container
 	// this is used for --filter=since= and --since=, the latter is deprecated.
 	sinceFilter *container.Container
+	// defaultIsolation is the daemon's default isolation, used to match
+	// containers created with the default isolation on Windows
+	defaultIsolation containertypes.Isolation
 	// ContainerListOptions is the filters set by the user
 	*types.ContainerListOptions
 }
@@ -238,6 +242,7 @@ func (daemon *Daemon) foldFilter(config *types.ContainerListOptions) (*listConte
 		sinceContainer:       sinceContainer,
 		beforeFilter:         beforeContFilter,
 		sinceFilter:          sinceContFilter,
+		defaultIsolation:     daemon.defaultIsolation,
 		ContainerListOptions: config,
 		names:                daemon.nameIndex.GetAll(),
 	}, nil
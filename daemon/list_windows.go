@@ -13,7 +13,10 @@ func excludeByIsolation(container *container.Container, ctx *listContext) iterat
 	if i == "" {
 		i = "default"
 	}
-	if !ctx.filters.Match("isolation", i) {
+	// A container with the default isolation matches both "default"
+	// and the isolation it runs with.
+	effective := strings.ToLower(string(resolveIsolation(container.HostConfig.Isolation, ctx.defaultIsolation)))
+	if !ctx.filters.Match("isolation", i) && !ctx.filters.Match("isolation", effective) {
 		return excludeContainer
 	}
 	return includeContainer
@@ -0,0 +1,60 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/docker/docker/container"
+	containertypes "github.com/docker/engine-api/types/container"
+	"github.com/docker/engine-api/types/filters"
+)
+
+func TestExcludeByIsolation(t *testing.T) {
+	cases := []struct {
+		isolation containertypes.Isolation
+		filter    string
+		expected  iterationAction
+	}{
+		{"", "default", includeContainer},
+		{"default", "default", includeContainer},
+		{"", "hyperv", includeContainer},
+		{"default", "hyperv", includeContainer},
+		{"", "process", excludeContainer},
+		{"process", "process", includeContainer},
+		{"process", "default", excludeContainer},
+		{"process", "hyperv", excludeContainer},
+		{"hyperv", "hyperv", includeContainer},
+	}
+
+	for _, tc := range cases {
+		psFilters := filters.NewArgs()
+		psFilters.Add("isolation", tc.filter)
+		ctx := &listContext{
+			filters:          psFilters,
+			defaultIsolation: containertypes.Isolation("hyperv"),
+		}
+		c := &container.Container{
+			CommonContainer: container.CommonContainer{
+				HostConfig: &containertypes.HostConfig{Isolation: tc.isolation},
+			},
+		}
+
+		if action := excludeByIsolation(c, ctx); action != tc.expected {
+			t.Fatalf("isolation %q with filter %q: expected %v, got %v", tc.isolation, tc.filter, tc.expected, action)
+		}
+	}
+}
+
+func TestEffectiveIsolation(t *testing.T) {
+	daemon := &Daemon{defaultIsolation: containertypes.Isolation("hyperv")}
+
+	for isolation, expected := range map[containertypes.Isolation]containertypes.Isolation{
+		"":        "hyperv",
+		"default": "hyperv",
+		"process": "process",
+		"hyperv":  "hyperv",
+	} {
+		if effective := daemon.effectiveIsolation(isolation); effective != expected {
+			t.Fatalf("isolation %q: expected %q, got %q", isolation, expected, effective)
+		}
+	}
+}
@@ -8,10 +8,21 @@ import (
 	"strconv"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/docker/docker/container"
 	"github.com/docker/docker/libcontainerd"
 	"github.com/docker/docker/runconfig"
 )
 
+// dieAttributes returns the attributes of the die event of a container,
+// including how many times it has been restarted so that consumers can
+// tell a crash loop apart from a single exit.
+func dieAttributes(c *container.Container, exitCode uint32) map[string]string {
+	return map[string]string{
+		"exitCode":     strconv.Itoa(int(exitCode)),
+		"restartCount": strconv.Itoa(c.RestartCount),
+	}
+}
+
 // StateChanged updates daemon state changes from containerd
 func (daemon *Daemon) StateChanged(id string, e libcontainerd.StateInfo) error {
 	c := daemon.containers.Get(id)
@@ -32,10 +43,7 @@ func (daemon *Daemon) StateChanged(id string, e libcontainerd.StateInfo) error {
 		c.Wait()
 		c.Reset(false)
 		c.SetStopped(platformConstructExitStatus(e))
-		attributes := map[string]string{
-			"exitCode": strconv.Itoa(int(e.ExitCode)),
-		}
-		daemon.LogContainerEventWithAttributes(c, "die", attributes)
+		daemon.LogContainerEventWithAttributes(c, "die", dieAttributes(c, e.ExitCode))
 		daemon.Cleanup(c)
 		// FIXME: here is race condition between two RUN instructions in Dockerfile
 		// because they share same runconfig and change image. Must be fixed
@@ -50,10 +58,7 @@ func (daemon *Daemon) StateChanged(id string, e libcontainerd.StateInfo) error {
 		c.Reset(false)
 		c.RestartCount++
 		c.SetRestarting(platformConstructExitStatus(e))
-		attributes := map[string]string{
-			"exitCode": strconv.Itoa(int(e.ExitCode)),
-		}
-		daemon.LogContainerEventWithAttributes(c, "die", attributes)
+		daemon.LogContainerEventWithAttributes(c, "die", dieAttributes(c, e.ExitCode))
 		if err := c.ToDisk(); err != nil {
 			return err
 		}
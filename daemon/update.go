@@ -2,6 +2,7 @@ package daemon
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/docker/engine-api/types/container"
@@ -90,11 +91,47 @@ func (daemon *Daemon) update(name string, hostConfig *container.HostConfig) erro
 		}
 	}
 
-	daemon.LogContainerEvent(container, "update")
+	daemon.LogContainerEventWithAttributes(container, "update", updateAttributes(&backupHostConfig, container.HostConfig))
 
 	return nil
 }
 
+// updateAttributes returns the event attributes for the settings that
+// changed between the two host configurations. Each changed setting is
+// reported with its new value, and its previous value under the same
+// name prefixed with "old".
+func updateAttributes(before, after *container.HostConfig) map[string]string {
+	attributes := map[string]string{}
+	add := func(name string, oldValue, newValue interface{}) {
+		if oldValue == newValue {
+			return
+		}
+		attributes[name] = fmt.Sprintf("%v", newValue)
+		attributes["old"+strings.ToUpper(name[:1])+name[1:]] = fmt.Sprintf("%v", oldValue)
+	}
+
+	add("blkioWeight", before.BlkioWeight, after.BlkioWeight)
+	add("cpuShares", before.CPUShares, after.CPUShares)
+	add("cpuPeriod", before.CPUPeriod, after.CPUPeriod)
+	add("cpuQuota", before.CPUQuota, after.CPUQuota)
+	add("cpusetCpus", before.CpusetCpus, after.CpusetCpus)
+	add("cpusetMems", before.CpusetMems, after.CpusetMems)
+	add("memory", before.Memory, after.Memory)
+	add("memorySwap", before.MemorySwap, after.MemorySwap)
+	add("memoryReservation", before.MemoryReservation, after.MemoryReservation)
+	add("kernelMemory", before.KernelMemory, after.KernelMemory)
+	add("restartPolicy", restartPolicyString(before.RestartPolicy), restartPolicyString(after.RestartPolicy))
+
+	return attributes
+}
+
+func restartPolicyString(policy container.RestartPolicy) string {
+	if policy.IsOnFailure() && policy.MaximumRetryCount > 0 {
+		return fmt.Sprintf("%s:%d", policy.Name, policy.MaximumRetryCount)
+	}
+	return policy.Name
+}
+
 func errCannotUpdate(containerID string, err error) error {
 	return fmt.Errorf("Cannot update container %s: %v", containerID, err)
 }
@@ -0,0 +1,41 @@
+package daemon
+
+import (
+	"testing"
+
+	containertypes "github.com/docker/engine-api/types/container"
+)
+
+func TestUpdateAttributes(t *testing.T) {
+	before := &containertypes.HostConfig{
+		Resources: containertypes.Resources{
+			CPUShares: 512,
+			Memory:    64 * 1024 * 1024,
+		},
+		RestartPolicy: containertypes.RestartPolicy{Name: "no"},
+	}
+	after := &containertypes.HostConfig{
+		Resources: containertypes.Resources{
+			CPUShares: 512,
+			Memory:    128 * 1024 * 1024,
+		},
+		RestartPolicy: containertypes.RestartPolicy{Name: "on-failure", MaximumRetryCount: 3},
+	}
+
+	attributes := updateAttributes(before, after)
+
+	expected := map[string]string{
+		"memory":           "134217728",
+		"oldMemory":        "67108864",
+		"restartPolicy":    "on-failure:3",
+		"oldRestartPolicy": "no",
+	}
+	if len(attributes) != len(expected) {
+		t.Fatalf("Expected attributes %v, got %v", expected, attributes)
+	}
+	for k, v := range expected {
+		if attributes[k] != v {
+			t.Fatalf("Expected attribute %s to be %q, got %q", k, v, attributes[k])
+		}
+	}
+}
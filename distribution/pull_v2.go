@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"runtime"
+	"strings"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/docker/distribution"
@@ -578,6 +579,11 @@ func (p *v2Puller) pullSchema2(ctx context.Context, ref reference.Named, mfst *s
 		}
 	}
 
+	if msg := platformMismatch(unmarshalledConfig); msg != "" {
+		logrus.Warnf("%s: %s", ref.String(), msg)
+		progress.Message(p.config.ProgressOutput, "", "WARNING: "+msg)
+	}
+
 	// The DiffIDs returned in rootFS MUST match those in the config.
 	// Otherwise the image config could be referencing layers that aren't
 	// included in the manifest.
@@ -599,6 +605,23 @@ func (p *v2Puller) pullSchema2(ctx context.Context, ref reference.Named, mfst *s
 	return imageID, manifestDigest, nil
 }
 
+// platformMismatch returns a description of the difference between the
+// platform the image was built for and the platform of the daemon, or an
+// empty string if the image can run here or doesn't declare its platform.
+func platformMismatch(img image.Image) string {
+	if (img.OS == "" || img.OS == runtime.GOOS) && (img.Architecture == "" || img.Architecture == runtime.GOARCH) {
+		return ""
+	}
+	imgOS, imgArch := img.OS, img.Architecture
+	if imgOS == "" {
+		imgOS = runtime.GOOS
+	}
+	if imgArch == "" {
+		imgArch = runtime.GOARCH
+	}
+	return fmt.Sprintf("the image platform %s/%s does not match the daemon platform %s/%s", imgOS, imgArch, runtime.GOOS, runtime.GOARCH)
+}
+
 func receiveConfig(configChan <-chan []byte, errChan <-chan error) ([]byte, image.Image, error) {
 	select {
 	case configJSON := <-configChan:
@@ -634,7 +657,11 @@ func (p *v2Puller) pullManifestList(ctx context.Context, ref reference.Named, mf
 	}
 
 	if manifestDigest == "" {
-		return "", "", errors.New("no supported platform found in manifest list")
+		var platforms []string
+		for _, manifestDescriptor := range mfstList.Manifests {
+			platforms = append(platforms, manifestDescriptor.Platform.OS+"/"+manifestDescriptor.Platform.Architecture)
+		}
+		return "", "", fmt.Errorf("no matching manifest for %s/%s in the manifest list entries (available: %s)", runtime.GOOS, runtime.GOARCH, strings.Join(platforms, ", "))
 	}
 
 	manSvc, err := p.repo.Manifests(ctx)
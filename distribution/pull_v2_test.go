@@ -10,6 +10,7 @@ import (
 
 	"github.com/docker/distribution/digest"
 	"github.com/docker/distribution/manifest/schema1"
+	"github.com/docker/docker/image"
 	"github.com/docker/docker/reference"
 )
 
@@ -181,3 +182,22 @@ func TestValidateManifest(t *testing.T) {
 		t.Fatal("expected validateManifest to fail with digest error")
 	}
 }
+
+func TestPlatformMismatch(t *testing.T) {
+	img := image.Image{}
+	if msg := platformMismatch(img); msg != "" {
+		t.Fatalf("expected no mismatch for an image without platform, got %q", msg)
+	}
+
+	img.OS = runtime.GOOS
+	img.Architecture = runtime.GOARCH
+	if msg := platformMismatch(img); msg != "" {
+		t.Fatalf("expected no mismatch for an image of the daemon platform, got %q", msg)
+	}
+
+	img.Architecture = "not-" + runtime.GOARCH
+	msg := platformMismatch(img)
+	if !strings.Contains(msg, runtime.GOOS+"/not-"+runtime.GOARCH) {
+		t.Fatalf("expected the image platform in the mismatch, got %q", msg)
+	}
+}
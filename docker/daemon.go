@@ -161,6 +161,7 @@ func (cli *DaemonCli) CmdDaemon(args ...string) error {
 	}
 
 	configFile := cli.flags.String([]string{daemonConfigFileFlag}, defaultDaemonConfigFile, "Daemon configuration file")
+	validate := cli.flags.Bool([]string{"-validate"}, false, "Validate the daemon configuration and exit")
 
 	cli.flags.ParseFlags(args, true)
 	commonFlags.PostParse()
@@ -175,6 +176,15 @@ func (cli *DaemonCli) CmdDaemon(args ...string) error {
 	}
 	cli.Config = cliConfig
 
+	if *validate {
+		if err := validateDaemonConfig(cli.Config); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Fprintln(os.Stdout, "configuration OK")
+		return nil
+	}
+
 	if cli.Config.Debug {
 		utils.EnableDebug()
 	}
@@ -192,10 +202,8 @@ func (cli *DaemonCli) CmdDaemon(args ...string) error {
 		logrus.Fatalf("Failed to set umask: %v", err)
 	}
 
-	if len(cli.LogConfig.Config) > 0 {
-		if err := logger.ValidateLogOpts(cli.LogConfig.Type, cli.LogConfig.Config); err != nil {
-			logrus.Fatalf("Failed to set log opts: %v", err)
-		}
+	if err := validateLogOpts(cli.Config); err != nil {
+		logrus.Fatal(err)
 	}
 
 	var pfile *pidfile.PIDFile
@@ -220,40 +228,21 @@ func (cli *DaemonCli) CmdDaemon(args ...string) error {
 	serverConfig = setPlatformServerConfig(serverConfig, cli.Config)
 
 	if cli.Config.TLS {
-		tlsOptions := tlsconfig.Options{
-			CAFile:   cli.Config.CommonTLSOptions.CAFile,
-			CertFile: cli.Config.CommonTLSOptions.CertFile,
-			KeyFile:  cli.Config.CommonTLSOptions.KeyFile,
-		}
-
-		if cli.Config.TLSVerify {
-			// server requires and verifies client's certificate
-			tlsOptions.ClientAuth = tls.RequireAndVerifyClientCert
-		}
-		tlsConfig, err := tlsconfig.Server(tlsOptions)
+		tlsConfig, err := newServerTLSConfig(cli.Config)
 		if err != nil {
 			logrus.Fatal(err)
 		}
 		serverConfig.TLSConfig = tlsConfig
 	}
 
-	if len(cli.Config.Hosts) == 0 {
-		cli.Config.Hosts = make([]string, 1)
+	if err := parseHosts(cli.Config); err != nil {
+		logrus.Fatal(err)
 	}
 
 	api := apiserver.New(serverConfig)
 
-	for i := 0; i < len(cli.Config.Hosts); i++ {
-		var err error
-		if cli.Config.Hosts[i], err = opts.ParseHost(cli.Config.TLS, cli.Config.Hosts[i]); err != nil {
-			logrus.Fatalf("error parsing -H %s : %v", cli.Config.Hosts[i], err)
-		}
-
-		protoAddr := cli.Config.Hosts[i]
+	for _, protoAddr := range cli.Config.Hosts {
 		protoAddrParts := strings.SplitN(protoAddr, "://", 2)
-		if len(protoAddrParts) != 2 {
-			logrus.Fatalf("bad format %s, expected PROTO://ADDR", protoAddr)
-		}
 
 		proto := protoAddrParts[0]
 		addr := protoAddrParts[1]
@@ -325,6 +314,11 @@ func (cli *DaemonCli) CmdDaemon(args ...string) error {
 			}
 
 		}
+		// debug mode always logs at debug level; only apply the
+		// configured level when it's not enabled.
+		if config.IsValueSet("log-level") && !utils.IsDebugEnabled() {
+			setDaemonLogLevel(config.LogLevel)
+		}
 	}
 
 	setupConfigReloadTrap(*configFile, cli.flags, reload)
@@ -422,6 +416,71 @@ func loadDaemonCliConfig(config *daemon.Config, daemonFlags *flag.FlagSet, commo
 	return config, nil
 }
 
+// validateDaemonConfig runs the checks the daemon does on its
+// configuration before it starts: log options, TLS certificates,
+// listen addresses and daemon settings. It does not check anything
+// that needs the daemon to be running, like the storage driver or
+// binding to the listen addresses.
+func validateDaemonConfig(config *daemon.Config) error {
+	if err := validateLogOpts(config); err != nil {
+		return err
+	}
+	if config.TLS {
+		if _, err := newServerTLSConfig(config); err != nil {
+			return err
+		}
+	}
+	if err := parseHosts(config); err != nil {
+		return err
+	}
+	return daemon.VerifySettings(config)
+}
+
+// validateLogOpts checks the options of the default logging driver.
+func validateLogOpts(config *daemon.Config) error {
+	if len(config.LogConfig.Config) > 0 {
+		if err := logger.ValidateLogOpts(config.LogConfig.Type, config.LogConfig.Config); err != nil {
+			return fmt.Errorf("Failed to set log opts: %v", err)
+		}
+	}
+	return nil
+}
+
+// newServerTLSConfig loads the certificates the API server uses for TLS.
+func newServerTLSConfig(config *daemon.Config) (*tls.Config, error) {
+	tlsOptions := tlsconfig.Options{
+		CAFile:   config.CommonTLSOptions.CAFile,
+		CertFile: config.CommonTLSOptions.CertFile,
+		KeyFile:  config.CommonTLSOptions.KeyFile,
+	}
+
+	if config.TLSVerify {
+		// server requires and verifies client's certificate
+		tlsOptions.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsconfig.Server(tlsOptions)
+}
+
+// parseHosts replaces the addresses the daemon listens on with their
+// PROTO://ADDR form, using the default address if none is set.
+func parseHosts(config *daemon.Config) error {
+	if len(config.Hosts) == 0 {
+		config.Hosts = make([]string, 1)
+	}
+
+	for i, host := range config.Hosts {
+		protoAddr, err := opts.ParseHost(config.TLS, host)
+		if err != nil {
+			return fmt.Errorf("error parsing -H %s : %v", host, err)
+		}
+		if len(strings.SplitN(protoAddr, "://", 2)) != 2 {
+			return fmt.Errorf("bad format %s, expected PROTO://ADDR", protoAddr)
+		}
+		config.Hosts[i] = protoAddr
+	}
+	return nil
+}
+
 func initRouter(s *apiserver.Server, d *daemon.Daemon) {
 	decoder := runconfig.ContainerDecoder{}
 
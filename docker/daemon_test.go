@@ -294,3 +294,50 @@ func TestLoadDaemonConfigWithRegistryOptions(t *testing.T) {
 		t.Fatal("expected disable-legacy-registry to be true, got false")
 	}
 }
+
+func TestValidateDaemonConfig(t *testing.T) {
+	cases := []struct {
+		config func(*daemon.Config)
+		errMsg string
+	}{
+		{
+			config: func(c *daemon.Config) {},
+		},
+		{
+			config: func(c *daemon.Config) { c.Hosts = []string{"udp://127.0.0.1:2375"} },
+			errMsg: "error parsing -H udp://127.0.0.1:2375",
+		},
+		{
+			config: func(c *daemon.Config) {
+				c.TLS = true
+				c.CommonTLSOptions.CertFile = "/tmp/fooobarbaz/cert.pem"
+				c.CommonTLSOptions.KeyFile = "/tmp/fooobarbaz/key.pem"
+			},
+			errMsg: "Could not load X509 key pair",
+		},
+		{
+			config: func(c *daemon.Config) {
+				c.LogConfig.Type = "json-file"
+				c.LogConfig.Config = map[string]string{"foo": "bar"}
+			},
+			errMsg: "Failed to set log opts",
+		},
+	}
+
+	for _, tc := range cases {
+		c := &daemon.Config{}
+		c.InstallFlags(mflag.NewFlagSet("test", mflag.ContinueOnError), func(s string) string { return s })
+		tc.config(c)
+
+		err := validateDaemonConfig(c)
+		if tc.errMsg == "" {
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.errMsg) {
+			t.Fatalf("expected error containing %q, got %v", tc.errMsg, err)
+		}
+	}
+}
@@ -41,6 +41,13 @@ type store struct {
 	images    map[ID]*imageMeta
 	fs        StoreBackend
 	digestSet *digest.Set
+
+	// configs and parents hold the config and the parent of every image
+	// in images, so Get and GetParent do not read them from fs. A config
+	// never changes under its ID. An empty parent means there is none.
+	cacheLock sync.RWMutex
+	configs   map[ID][]byte
+	parents   map[ID]ID
 }
 
 // NewImageStore returns new store object for given layer store
@@ -50,6 +57,8 @@ func NewImageStore(fs StoreBackend, ls LayerGetReleaser) (Store, error) {
 		images:    make(map[ID]*imageMeta),
 		fs:        fs,
 		digestSet: digest.NewSet(),
+		configs:   make(map[ID][]byte),
+		parents:   make(map[ID]ID),
 	}
 
 	// load all current images and retain layers
@@ -62,7 +71,12 @@ func NewImageStore(fs StoreBackend, ls LayerGetReleaser) (Store, error) {
 
 func (is *store) restore() error {
 	err := is.fs.Walk(func(id ID) error {
-		img, err := is.Get(id)
+		config, err := is.fs.Get(id)
+		if err != nil {
+			logrus.Errorf("invalid image %v, %v", id, err)
+			return nil
+		}
+		img, err := is.newImage(id, config)
 		if err != nil {
 			logrus.Errorf("invalid image %v, %v", id, err)
 			return nil
@@ -84,6 +98,8 @@ func (is *store) restore() error {
 		}
 
 		is.images[ID(id)] = imageMeta
+		is.configs[ID(id)] = config
+		is.parents[ID(id)] = img.Parent
 
 		return nil
 	})
@@ -161,6 +177,11 @@ func (is *store) Create(config []byte) (ID, error) {
 		return "", err
 	}
 
+	is.cacheLock.Lock()
+	is.configs[imageID] = config
+	is.parents[imageID] = ""
+	is.cacheLock.Unlock()
+
 	return imageID, nil
 }
 
@@ -179,13 +200,24 @@ func (is *store) Search(term string) (ID, error) {
 }
 
 func (is *store) Get(id ID) (*Image, error) {
-	// todo: Check if image is in images
 	// todo: Detect manual insertions and start using them
-	config, err := is.fs.Get(id)
-	if err != nil {
-		return nil, err
+	is.cacheLock.RLock()
+	config, cached := is.configs[id]
+	is.cacheLock.RUnlock()
+
+	if !cached {
+		var err error
+		config, err = is.fs.Get(id)
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	return is.newImage(id, config)
+}
+
+// newImage builds the image with the given ID from its config.
+func (is *store) newImage(id ID, config []byte) (*Image, error) {
 	img, err := NewFromJSON(config)
 	if err != nil {
 		return nil, err
@@ -208,9 +240,12 @@ func (is *store) Delete(id ID) ([]layer.Metadata, error) {
 	if imageMeta == nil {
 		return nil, fmt.Errorf("unrecognized image ID %s", id.String())
 	}
+	is.cacheLock.Lock()
 	for id := range imageMeta.children {
 		is.fs.DeleteMetadata(id, "parent")
+		is.parents[id] = ""
 	}
+	is.cacheLock.Unlock()
 	if parent, err := is.GetParent(id); err == nil && is.images[parent] != nil {
 		delete(is.images[parent].children, id)
 	}
@@ -219,6 +254,10 @@ func (is *store) Delete(id ID) ([]layer.Metadata, error) {
 		logrus.Errorf("error removing %s from digest set: %q", id, err)
 	}
 	delete(is.images, id)
+	is.cacheLock.Lock()
+	delete(is.configs, id)
+	delete(is.parents, id)
+	is.cacheLock.Unlock()
 	is.fs.Delete(id)
 
 	if imageMeta.layer != nil {
@@ -238,10 +277,29 @@ func (is *store) SetParent(id, parent ID) error {
 		delete(is.images[parent].children, id)
 	}
 	parentMeta.children[id] = struct{}{}
-	return is.fs.SetMetadata(id, "parent", []byte(parent))
+	if err := is.fs.SetMetadata(id, "parent", []byte(parent)); err != nil {
+		return err
+	}
+	if is.images[id] != nil {
+		is.cacheLock.Lock()
+		is.parents[id] = parent
+		is.cacheLock.Unlock()
+	}
+	return nil
 }
 
 func (is *store) GetParent(id ID) (ID, error) {
+	is.cacheLock.RLock()
+	parent, cached := is.parents[id]
+	is.cacheLock.RUnlock()
+
+	if cached {
+		if parent == "" {
+			return "", fmt.Errorf("no parent for image %s", id.String())
+		}
+		return parent, nil
+	}
+
 	d, err := is.fs.GetMetadata(id, "parent")
 	if err != nil {
 		return "", err
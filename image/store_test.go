@@ -289,6 +289,101 @@ func TestParentReset(t *testing.T) {
 
 }
 
+func TestGetCachesConfigAndParent(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "images-fs-store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+	fsBackend, err := NewFSStoreBackend(tmpdir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id1, err := fsBackend.Set([]byte(`{"comment": "abc", "rootfs": {"type": "layers"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	id2, err := fsBackend.Set([]byte(`{"comment": "def", "rootfs": {"type": "layers"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fsBackend.SetMetadata(id2, "parent", []byte(id1)); err != nil {
+		t.Fatal(err)
+	}
+
+	fs := &countingStoreBackend{StoreBackend: fsBackend}
+	is, err := NewImageStore(fs, &mockLayerGetReleaser{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id3, err := is.Create([]byte(`{"comment": "ghi", "rootfs": {"type": "layers"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := is.SetParent(id3, ID(id1)); err != nil {
+		t.Fatal(err)
+	}
+
+	fs.reads = 0
+	for _, id := range []ID{ID(id1), ID(id2), id3, ID(id1), ID(id2), id3} {
+		if _, err := is.Get(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := is.GetParent(ID(id1)); err == nil {
+		t.Fatal("expected error for getting parent")
+	}
+	for _, id := range []ID{ID(id2), id3} {
+		img, err := is.Get(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if img.Parent != ID(id1) {
+			t.Fatalf("expected parent %s for %s, got %s", id1, id, img.Parent)
+		}
+	}
+	if fs.reads != 0 {
+		t.Fatalf("expected configs and parents to be served from the cache, got %d backend reads", fs.reads)
+	}
+
+	if _, err := is.Delete(ID(id1)); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []ID{ID(id2), id3} {
+		if _, err := is.GetParent(id); err == nil {
+			t.Fatalf("expected no parent for %s after deleting its parent", id)
+		}
+	}
+	if fs.reads != 0 {
+		t.Fatalf("expected parents to be served from the cache, got %d backend reads", fs.reads)
+	}
+
+	if _, err := is.Get(ID(id1)); err == nil {
+		t.Fatal("expected error getting a deleted image")
+	}
+	if fs.reads == 0 {
+		t.Fatal("expected a deleted image to be read from the backend")
+	}
+}
+
+// countingStoreBackend counts the reads of image configs and metadata.
+type countingStoreBackend struct {
+	StoreBackend
+	reads int
+}
+
+func (fs *countingStoreBackend) Get(id ID) ([]byte, error) {
+	fs.reads++
+	return fs.StoreBackend.Get(id)
+}
+
+func (fs *countingStoreBackend) GetMetadata(id ID, key string) ([]byte, error) {
+	fs.reads++
+	return fs.StoreBackend.GetMetadata(id, key)
+}
+
 type mockLayerGetReleaser struct{}
 
 func (ls *mockLayerGetReleaser) Get(layer.ChainID) (layer.Layer, error) {
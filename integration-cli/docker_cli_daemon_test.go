@@ -2221,3 +2221,28 @@ func (s *DockerSuite) TestDaemonDiscoveryBackendConfigReload(c *check.C) {
 	c.Assert(out, checker.Contains, fmt.Sprintf("Cluster Store: consul://consuladdr:consulport/some/path"))
 	c.Assert(out, checker.Contains, fmt.Sprintf("Cluster Advertise: 192.168.56.100:0"))
 }
+
+func (s *DockerDaemonSuite) TestDaemonValidateConfig(c *check.C) {
+	dir, err := ioutil.TempDir("", "test-daemon-validate")
+	c.Assert(err, checker.IsNil)
+	defer os.RemoveAll(dir)
+
+	valid := filepath.Join(dir, "valid.json")
+	err = ioutil.WriteFile(valid, []byte(`{"log-level": "warn", "hosts": ["unix:///var/run/docker-validate.sock"]}`), 0644)
+	c.Assert(err, checker.IsNil)
+
+	out, exitCode, err := runCommandWithOutput(exec.Command(dockerBinary, "daemon", "--validate", "--config-file", valid))
+	c.Assert(err, checker.IsNil, check.Commentf("%s", out))
+	c.Assert(exitCode, checker.Equals, 0)
+	c.Assert(out, checker.Contains, "configuration OK")
+
+	invalid := filepath.Join(dir, "invalid.json")
+	err = ioutil.WriteFile(invalid, []byte(`{"hosts": ["udp://127.0.0.1:2375"]}`), 0644)
+	c.Assert(err, checker.IsNil)
+
+	out, exitCode, err = runCommandWithOutput(exec.Command(dockerBinary, "daemon", "--validate", "--config-file", invalid))
+	c.Assert(err, checker.NotNil, check.Commentf("%s", out))
+	c.Assert(exitCode, checker.Equals, 1)
+	c.Assert(out, checker.Contains, "error parsing -H udp://127.0.0.1:2375")
+	c.Assert(out, checker.Not(checker.Contains), "configuration OK")
+}